@@ -0,0 +1,177 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/umlx5h/gtrash/internal/config"
+	"github.com/umlx5h/gtrash/internal/glog"
+	"github.com/umlx5h/gtrash/internal/trash"
+)
+
+type autocleanCmd struct {
+	cmd  *cobra.Command
+	opts autocleanOptions
+}
+
+type autocleanOptions struct {
+	lifetime string
+
+	watch    bool
+	interval string
+
+	installUnit bool
+}
+
+func newAutocleanCmd() *autocleanCmd {
+	root := &autocleanCmd{}
+	cmd := &cobra.Command{
+		Use:   "autoclean",
+		Short: "Permanently remove trashed files whose retention period has expired",
+		Long: `Description:
+  Scans all trash roots and permanently removes files that have expired.
+
+  A file is expired when its own expiry (recorded at deletion time via --lifetime on put)
+  is in the past, or, when no expiry was recorded, when DeletionDate + --lifetime is in the past.
+
+  --lifetime defaults to default_lifetime in ~/.config/gtrash/config.yaml, falling back to 30d.`,
+		Example: `  # Remove everything that has expired using the default lifetime
+  $ gtrash autoclean
+
+  # Use an explicit default lifetime of 2 weeks
+  $ gtrash autoclean --lifetime 2w
+
+  # Run forever, sweeping every hour
+  $ gtrash autoclean --watch --interval 1h
+
+  # Generate and install a systemd user unit/timer that runs the sweep hourly
+  $ gtrash autoclean --install-unit`,
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := autocleanCmdRun(root.opts); err != nil {
+				return err
+			}
+			if glog.ExitCode() > 0 {
+				return errContinue
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&root.opts.lifetime, "lifetime", "", `Default lifetime applied to files with no recorded expiry (e.g. 10s, 72h, 14d, 4w)
+Falls back to default_lifetime in ~/.config/gtrash/config.yaml, then to 30d`)
+	cmd.Flags().BoolVar(&root.opts.watch, "watch", false, "Run forever, sweeping on a ticker instead of exiting after one pass")
+	cmd.Flags().StringVar(&root.opts.interval, "interval", "1h", "Sweep interval used with --watch")
+	cmd.Flags().BoolVar(&root.opts.installUnit, "install-unit", false, "Generate and install a systemd user unit/timer that runs 'gtrash autoclean' periodically, then exit")
+
+	root.cmd = cmd
+	return root
+}
+
+func autocleanCmdRun(opts autocleanOptions) error {
+	if opts.installUnit {
+		return installAutocleanUnit(opts.interval)
+	}
+
+	lifetimeStr := opts.lifetime
+	if lifetimeStr == "" {
+		if cfg, err := config.Load(); err == nil {
+			lifetimeStr = cfg.DefaultLifetime
+		}
+	}
+	if lifetimeStr == "" {
+		lifetimeStr = "30d"
+	}
+
+	lifetime, err := trash.ParseDuration(lifetimeStr)
+	if err != nil {
+		return fmt.Errorf("invalid --lifetime: %w", err)
+	}
+
+	if !opts.watch {
+		return sweepExpired(lifetime)
+	}
+
+	interval, err := trash.ParseDuration(opts.interval)
+	if err != nil {
+		return fmt.Errorf("invalid --interval: %w", err)
+	}
+
+	slog.Info("starting autoclean daemon", "lifetime", lifetime, "interval", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := sweepExpired(lifetime); err != nil {
+			slog.Error("autoclean sweep failed", "error", err)
+		}
+		<-ticker.C
+	}
+}
+
+// sweepExpired removes every trashed file whose expiry (explicit, or derived
+// from defaultLifetime) has already passed, printing what it removed.
+func sweepExpired(defaultLifetime time.Duration) error {
+	box := trash.NewBox(
+		trash.WithExpiry(true, 0, defaultLifetime),
+	)
+	if err := box.Open(); err != nil {
+		if errors.Is(err, trash.ErrNotFound) {
+			fmt.Println("No expired files found")
+			return nil
+		}
+		return err
+	}
+
+	for _, f := range box.Files {
+		fmt.Printf("removing %s (deleted %s)\n", f.OriginalPath, f.DeletedAt.Format(time.DateTime))
+	}
+
+	return doRemove(box.Files)
+}
+
+func installAutocleanUnit(interval string) error {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return err
+	}
+
+	unitDir := filepath.Join(configDir, "systemd", "user")
+	if err := os.MkdirAll(unitDir, 0o755); err != nil {
+		return err
+	}
+
+	service := `[Unit]
+Description=gtrash autoclean sweeper
+
+[Service]
+Type=oneshot
+ExecStart=gtrash autoclean
+`
+	timer := fmt.Sprintf(`[Unit]
+Description=Periodically run gtrash autoclean
+
+[Timer]
+OnBootSec=%s
+OnUnitActiveSec=%s
+Persistent=true
+
+[Install]
+WantedBy=timers.target
+`, interval, interval)
+
+	if err := os.WriteFile(filepath.Join(unitDir, "gtrash-autoclean.service"), []byte(service), 0o644); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(unitDir, "gtrash-autoclean.timer"), []byte(timer), 0o644); err != nil {
+		return err
+	}
+
+	fmt.Printf("Installed unit files in %s\nRun 'systemctl --user enable --now gtrash-autoclean.timer' to start it\n", unitDir)
+	return nil
+}
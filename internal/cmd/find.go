@@ -1,20 +1,33 @@
 package cmd
 
 import (
+	"encoding/csv"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/juju/ansiterm"
 	"github.com/spf13/cobra"
+	"github.com/umlx5h/gtrash/internal/config"
 	"github.com/umlx5h/gtrash/internal/glog"
 	"github.com/umlx5h/gtrash/internal/trash"
 	"github.com/umlx5h/gtrash/internal/tui"
 )
 
+// Supported values for the find --output flag.
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputJSONL = "jsonl"
+	outputCSV   = "csv"
+	outputNull  = "null"
+)
+
 type findCmd struct {
 	cmd  *cobra.Command
 	opts findOptions
@@ -31,12 +44,20 @@ type findOptions struct {
 	doRestore bool
 	force     bool
 
-	dayNew int // unit day
+	dayNew int // unit day, deprecated in favor of newerThan/olderThan
 	dayOld int
 
+	newerThan string
+	olderThan string
+
+	expired   bool
+	expiresIn string
+
 	sizeLarge string
 	sizeSmall string
 
+	any bool
+
 	reverse bool
 	last    int
 
@@ -44,6 +65,11 @@ type findOptions struct {
 	showSize      bool
 	showTrashPath bool
 
+	output   string
+	nullData bool // -0, shorthand for --output null
+
+	interactive bool
+
 	restoreTo string
 }
 
@@ -57,6 +83,13 @@ func newFindCmd() *findCmd {
   All deleted files can be displayed and searched.
   You can search by passing a string as a command line argument.
 
+  Multiple query tokens may be given; by default a path must match all of them,
+  pass --any to match if any token hits instead. Prefix a token with '!' to negate it,
+  e.g. 'gtrash find .log !archive' matches paths containing .log but not archive.
+  (A leading '-' is not supported for negation, since cobra/pflag would otherwise
+  try to parse it as a flag.)
+  Negation and the AND/OR combination behave identically regardless of --mode.
+
   If you want to delete or restore the searched files, pass the --rm and --restore options, respectively.`,
 		Example: `  # Show all trashed files
   $ gtrash find
@@ -84,16 +117,42 @@ func newFindCmd() *findCmd {
   $ gtrash find --restore
 
   # Remove files deleted more than a week ago
-  $ gtrash find --day-old 7 --rm
+  $ gtrash find --older-than 7d --rm
+
+  # Remove files deleted in the last 30 minutes
+  $ gtrash find --newer-than 30m --rm
 
   # Remove greater than 10MB trashed files
   $ gtrash find --size-large 10mb --rm
 
+  # Preview what 'gtrash autoclean' would remove
+  $ gtrash find --expired
+
+  # Show files expiring in the next day
+  $ gtrash find --expires-in 24h
+
+  # Pipe a filtered list to jq, or to 'xargs -0' for external tools
+  $ gtrash find -o jsonl --older-than 7d | jq
+  $ gtrash find -o null --older-than 7d | xargs -0 -o gtrash rm
+
+  # Match paths containing .log but not archive, then remove them
+  $ gtrash find --mode literal .log !archive --older-than 7d --rm
+
+  # Match paths containing either foo or bar
+  $ gtrash find --any foo bar
+
   # fuzzy find multiple items and remove them permanently
   # The -o in xargs is required to display the confirmation prompt.
-  $ gtrash find | fzf --multi | awk -F'\t' '{print $2}' | xargs -o gtrash rm`,
+  $ gtrash find | fzf --multi | awk -F'\t' '{print $2}' | xargs -o gtrash rm
+
+  # Explicitly launch the interactive selector (default when run in a terminal without --rm/--restore)
+  $ gtrash find --interactive`,
 		SilenceUsage: true,
-		RunE: func(_ *cobra.Command, args []string) error {
+		RunE: func(c *cobra.Command, args []string) error {
+			if !c.Flags().Changed("interactive") && isTerminal && root.opts.output == outputTable &&
+				!root.opts.doRemove && !root.opts.doRestore {
+				root.opts.interactive = true
+			}
 			if err := findCmdRun(args, root.opts); err != nil {
 				return err
 			}
@@ -133,18 +192,44 @@ full:
 This is not necessary if running outside of a terminal`)
 	cmd.Flags().IntVar(&root.opts.dayNew, "day-new", 0, "Filter by deletion date (within X day)")
 	cmd.Flags().IntVar(&root.opts.dayOld, "day-old", 0, "Filter by deletion date (before X day)")
+	cmd.Flags().StringVar(&root.opts.newerThan, "newer-than", "", "Filter by deletion date (within this duration, e.g. 30s, 15m, 6h, 3d, 2w)")
+	cmd.Flags().StringVar(&root.opts.olderThan, "older-than", "", "Filter by deletion date (before this duration, e.g. 30s, 15m, 6h, 3d, 2w)")
+	cmd.Flags().BoolVar(&root.opts.expired, "expired", false, "Filter by files that have already expired, previewing what 'gtrash autoclean' would remove")
+	cmd.Flags().StringVar(&root.opts.expiresIn, "expires-in", "", "Filter by files that will expire within this duration (e.g. 1h, 7d)")
 	cmd.Flags().BoolVarP(&root.opts.showSize, "show-size", "S", false, `Show size always
 Automatically enabled if --sort size, --size-large, --size-small specified
 If the size could not be obtained, it will be displayed as '-'`)
 	cmd.Flags().BoolVar(&root.opts.showTrashPath, "show-trashpath", false, "Show trash path")
+	cmd.Flags().BoolVar(&root.opts.any, "any", false, "Combine multiple query tokens with OR instead of the default AND")
 	cmd.Flags().BoolVarP(&root.opts.reverse, "reverse", "r", false, "Reverse sort order (default: ascending)")
 	cmd.Flags().StringVar(&root.opts.restoreTo, "restore-to", "", "Restore to this path instead of original path")
 	cmd.Flags().IntVarP(&root.opts.last, "last", "n", 0, "Show n last files")
+	cmd.Flags().StringVarP(&root.opts.output, "output", "o", outputTable, `Output format: table, json, jsonl, csv, null
+json/jsonl always include size (forcing --show-size on)
+null prints NUL-delimited original paths, for piping into 'xargs -0'`)
+	cmd.Flags().BoolVarP(&root.opts.nullData, "print0", "0", false, "Shorthand for --output null")
+	cmd.Flags().BoolVarP(&root.opts.interactive, "interactive", "i", false, `Open an interactive selector instead of printing the list
+Space to toggle selection, 'r' to restore, 'D' to remove PERMANENTLY, 'p' to preview, 't' to toggle the trash path column
+Defaults to on when stdin and stdout are a terminal, --output is table and neither --rm nor --restore is given`)
+
+	if err := cmd.Flags().MarkDeprecated("day-new", "use --newer-than instead"); err != nil {
+		panic(err)
+	}
+	if err := cmd.Flags().MarkDeprecated("day-old", "use --older-than instead"); err != nil {
+		panic(err)
+	}
 
 	cmd.MarkFlagsMutuallyExclusive("rm", "restore")
 	cmd.MarkFlagsMutuallyExclusive("directory", "cwd")
 	cmd.MarkFlagsMutuallyExclusive("day-new", "day-old")
+	cmd.MarkFlagsMutuallyExclusive("day-new", "newer-than")
+	cmd.MarkFlagsMutuallyExclusive("day-old", "older-than")
+	cmd.MarkFlagsMutuallyExclusive("newer-than", "older-than")
 	cmd.MarkFlagsMutuallyExclusive("size-large", "size-small")
+	cmd.MarkFlagsMutuallyExclusive("expired", "expires-in")
+	cmd.MarkFlagsMutuallyExclusive("output", "print0")
+	cmd.MarkFlagsMutuallyExclusive("interactive", "rm")
+	cmd.MarkFlagsMutuallyExclusive("interactive", "restore")
 
 	if err := cmd.RegisterFlagCompletionFunc("sort", trash.SortByFlagCompletionFunc); err != nil {
 		panic(err)
@@ -152,6 +237,17 @@ If the size could not be obtained, it will be displayed as '-'`)
 	if err := cmd.RegisterFlagCompletionFunc("mode", trash.ModeByFlagCompletionFunc); err != nil {
 		panic(err)
 	}
+	if err := cmd.RegisterFlagCompletionFunc("newer-than", trash.DurationFlagCompletionFunc); err != nil {
+		panic(err)
+	}
+	if err := cmd.RegisterFlagCompletionFunc("older-than", trash.DurationFlagCompletionFunc); err != nil {
+		panic(err)
+	}
+	if err := cmd.RegisterFlagCompletionFunc("output", cobra.FixedCompletions(
+		[]string{outputTable, outputJSON, outputJSONL, outputCSV, outputNull}, cobra.ShellCompDirectiveNoFileComp,
+	)); err != nil {
+		panic(err)
+	}
 
 	root.cmd = cmd
 	return root
@@ -164,15 +260,72 @@ func findCmdRun(args []string, opts findOptions) error {
 		return err
 	}
 
+	if opts.nullData {
+		opts.output = outputNull
+	}
+	switch opts.output {
+	case outputTable, outputNull:
+	case outputJSON, outputJSONL, outputCSV:
+		opts.showSize = true // always include size in structured output
+	default:
+		return fmt.Errorf("invalid --output %q: must be one of table, json, jsonl, csv, null", opts.output)
+	}
+
+	var expiresIn time.Duration
+	if opts.expiresIn != "" {
+		var err error
+		expiresIn, err = trash.ParseDuration(opts.expiresIn)
+		if err != nil {
+			return fmt.Errorf("invalid --expires-in: %w", err)
+		}
+	}
+
+	var newerThan, olderThan time.Duration
+	if opts.newerThan != "" {
+		var err error
+		newerThan, err = trash.ParseDuration(opts.newerThan)
+		if err != nil {
+			return fmt.Errorf("invalid --newer-than: %w", err)
+		}
+	}
+	if opts.olderThan != "" {
+		var err error
+		olderThan, err = trash.ParseDuration(opts.olderThan)
+		if err != nil {
+			return fmt.Errorf("invalid --older-than: %w", err)
+		}
+	}
+
+	var defaultLifetime time.Duration
+	if opts.expired || opts.expiresIn != "" {
+		lifetimeStr := ""
+		if cfg, err := config.Load(); err == nil {
+			lifetimeStr = cfg.DefaultLifetime
+		}
+		if lifetimeStr == "" {
+			lifetimeStr = "30d" // same fallback as 'gtrash autoclean'
+		}
+		if lifetimeStr != "" {
+			d, err := trash.ParseDuration(lifetimeStr)
+			if err != nil {
+				return fmt.Errorf("invalid default_lifetime in config: %w", err)
+			}
+			defaultLifetime = d
+		}
+	}
+
 	box := trash.NewBox(
 		trash.WithAscend(!opts.reverse),
 		trash.WithGetSize(opts.showSize),
 		trash.WithDirectory(opts.directory),
 		trash.WithCWD(opts.cwd),
 		trash.WithQueries(args),
+		trash.WithQueryMatchAny(opts.any),
 		trash.WithSortBy(opts.sortBy),
 		trash.WithQueryMode(opts.modeBy),
-		trash.WithDay(opts.dayNew, opts.dayOld), // TODO: also set in restore?
+		trash.WithDay(opts.dayNew, opts.dayOld), // TODO: also set in restore? deprecated, superseded by WithAge
+		trash.WithAge(newerThan, olderThan),
+		trash.WithExpiry(opts.expired, expiresIn, defaultLifetime),
 		trash.WithSize(opts.sizeLarge, opts.sizeSmall),
 		trash.WithLimitLast(opts.last),
 	)
@@ -180,10 +333,18 @@ func findCmdRun(args []string, opts findOptions) error {
 		return err
 	}
 
-	listFiles(box.Files, box.GetSize, opts.showTrashPath)
+	if opts.interactive {
+		return runInteractiveFind(box.Files, opts)
+	}
+
+	if opts.output == outputTable {
+		listFiles(box.Files, box.GetSize, opts.showTrashPath)
+	} else if err := printStructured(box.Files, len(box.OrphanMeta), opts.output); err != nil {
+		return err
+	}
 
 	if !opts.doRemove && !opts.doRestore {
-		if isTerminal {
+		if isTerminal && opts.output == outputTable {
 			fmt.Printf("\nFound %d trashed files. You can restore or remove PERMANENTLY these by --restore, --rm.\n", len(box.Files))
 			if len(box.OrphanMeta) > 0 {
 				fmt.Printf("\nFound invalid metadata: %d\nYou can remove invalid metadata by 'gtrash metafix'\n", len(box.OrphanMeta))
@@ -192,7 +353,9 @@ func findCmdRun(args []string, opts findOptions) error {
 		return nil
 	}
 
-	fmt.Printf("\nFound %d trashed files\n", len(box.Files))
+	if opts.output == outputTable {
+		fmt.Printf("\nFound %d trashed files\n", len(box.Files))
+	}
 
 	if opts.doRemove {
 		if !opts.force && isTerminal && !tui.BoolPrompt("Are you sure you want to remove PERMENANTLY? ") {
@@ -210,7 +373,7 @@ func findCmdRun(args []string, opts findOptions) error {
 		if !opts.force && isTerminal && !tui.BoolPrompt("Are you sure you want to restore? ") {
 			return errors.New("do nothing")
 		}
-		if err := doRestore(box.Files, opts.restoreTo); err != nil {
+		if err := doRestore(box.Files, opts.restoreTo, isTerminal && !opts.force); err != nil {
 			return err
 		}
 	}
@@ -218,6 +381,110 @@ func findCmdRun(args []string, opts findOptions) error {
 	return nil
 }
 
+// fileRecord is the stable schema emitted by the json, jsonl and csv output
+// formats, one entry per trash.File.
+type fileRecord struct {
+	OriginalPath string    `json:"original_path"`
+	TrashPath    string    `json:"trash_path"`
+	DeletedAt    time.Time `json:"deleted_at"`
+	SizeBytes    int64     `json:"size_bytes"`
+	IsDir        bool      `json:"is_dir"`
+	TrashRoot    string    `json:"trash_root"`
+}
+
+func newFileRecord(f trash.File) fileRecord {
+	var size int64
+	if f.Size != nil {
+		size = *f.Size
+	}
+
+	return fileRecord{
+		OriginalPath: f.OriginalPath,
+		TrashPath:    f.TrashPath,
+		DeletedAt:    f.DeletedAt,
+		SizeBytes:    size,
+		IsDir:        f.IsDir,
+		TrashRoot:    f.TrashDir,
+	}
+}
+
+// printStructured renders files in one of the scriptable output formats
+// (json, jsonl, csv, null). orphanMeta is only surfaced by the json format,
+// as {files: [...], orphan_meta: N}.
+func printStructured(files []trash.File, orphanMeta int, format string) error {
+	if format == outputNull {
+		for _, f := range files {
+			fmt.Print(f.OriginalPath + "\x00")
+		}
+		return nil
+	}
+
+	records := make([]fileRecord, 0, len(files))
+	for _, f := range files {
+		records = append(records, newFileRecord(f))
+	}
+
+	switch format {
+	case outputJSON:
+		return json.NewEncoder(os.Stdout).Encode(struct {
+			Files      []fileRecord `json:"files"`
+			OrphanMeta int          `json:"orphan_meta"`
+		}{records, orphanMeta})
+
+	case outputJSONL:
+		enc := json.NewEncoder(os.Stdout)
+		for _, r := range records {
+			if err := enc.Encode(r); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case outputCSV:
+		w := csv.NewWriter(os.Stdout)
+		if err := w.Write([]string{"original_path", "trash_path", "deleted_at", "size_bytes", "is_dir", "trash_root"}); err != nil {
+			return err
+		}
+		for _, r := range records {
+			if err := w.Write([]string{
+				r.OriginalPath,
+				r.TrashPath,
+				r.DeletedAt.Format(time.RFC3339),
+				strconv.FormatInt(r.SizeBytes, 10),
+				strconv.FormatBool(r.IsDir),
+				r.TrashRoot,
+			}); err != nil {
+				return err
+			}
+		}
+		w.Flush()
+		return w.Error()
+
+	default:
+		return fmt.Errorf("invalid --output %q", format)
+	}
+}
+
+// runInteractiveFind renders the already-loaded results in a Bubble Tea
+// multi-select list (case-insensitive substring filter, not fuzzy; space to toggle, 'r'/'D' to act, 'p' to
+// preview, 't' to toggle the trash path column) and performs the chosen
+// action on confirmation, honoring --restore-to.
+func runInteractiveFind(files []trash.File, opts findOptions) error {
+	action, selected, err := tui.FindSelect(files, opts.showTrashPath)
+	if err != nil {
+		return err
+	}
+
+	switch action {
+	case tui.FindActionRestore:
+		return doRestore(selected, opts.restoreTo, false)
+	case tui.FindActionRemove:
+		return doRemove(selected)
+	default:
+		return nil
+	}
+}
+
 // TODO: refactor
 func listFiles(files []trash.File, showSize, showTrashPath bool) {
 	if isTerminal {
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// readFileRecordsFromJSON reads the output of 'gtrash find -o json' or
+// '-o jsonl' back in, so that --from-json can drive rm/restore from a
+// filtered list. It accepts either shape: a single {files: [...]} object,
+// or one fileRecord per line.
+func readFileRecordsFromJSON(r io.Reader) ([]fileRecord, error) {
+	br := bufio.NewReader(r)
+
+	_, err := br.Peek(1)
+	if err != nil {
+		if err == io.EOF {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// Both shapes begin with '{': a value with a "files" key is the json
+	// wrapper, any other value is a single jsonl record.
+	var records []fileRecord
+	dec := json.NewDecoder(br)
+	for dec.More() {
+		var v struct {
+			fileRecord
+			Files []fileRecord `json:"files"`
+		}
+		if err := dec.Decode(&v); err != nil {
+			return nil, fmt.Errorf("decode json: %w", err)
+		}
+		if v.Files != nil {
+			records = append(records, v.Files...)
+		} else {
+			records = append(records, v.fileRecord)
+		}
+	}
+	return records, nil
+}
+
+// pathsFromJSON resolves --from-json into a list of original paths, reading
+// from stdin when path is "-" and from a regular file otherwise.
+func pathsFromJSON(path string) ([]string, error) {
+	var r io.Reader
+	if path == "-" {
+		r = os.Stdin
+	} else {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("open --from-json file: %w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	records, err := readFileRecordsFromJSON(r)
+	if err != nil {
+		return nil, fmt.Errorf("read --from-json: %w", err)
+	}
+
+	paths := make([]string, len(records))
+	for i, rec := range records {
+		paths[i] = rec.OriginalPath
+	}
+	return paths, nil
+}
@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/umlx5h/gtrash/internal/glog"
+	"github.com/umlx5h/gtrash/internal/trash"
+	"github.com/umlx5h/gtrash/internal/tui"
+)
+
+type removeCmd struct {
+	cmd  *cobra.Command
+	opts removeOptions
+}
+
+type removeOptions struct {
+	force    bool
+	fromJSON string
+}
+
+func newRemoveCmd() *removeCmd {
+	root := &removeCmd{}
+	cmd := &cobra.Command{
+		Use:   "rm [PATH...]",
+		Short: "Remove trashed files PERMANENTLY in the cmd arguments",
+		Long: `Descricption:
+  Permanently remove the files specified as command-line arguments.
+  Paths must be specified as full paths.
+
+  This command is intended to be used alongside other commands like fzf.
+  Generally, using 'find --rm' is recommended over this command.`,
+		Example: `  # Permanently remove files by providing full paths..
+  $ gtrash rm /home/user/file1 /home/user/file2
+
+  # Fuzzy find multiple items and permanently remove them.
+  # The -o in xargs is necessary for the confirmation prompt to display.
+  $ gtrash find | fzf --multi | awk -F'\t' '{print $2}' | xargs -o gtrash rm
+
+  # Pipe a filtered 'find' selection straight in
+  $ gtrash find --output json .log | gtrash rm --from-json -`,
+		SilenceUsage: true,
+		Args:         cobra.ArbitraryArgs,
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := removeCmdRun(args, root.opts); err != nil {
+				return err
+			}
+			if glog.ExitCode() > 0 {
+				return errContinue
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&root.opts.force, "force", "f", false, `Always execute without confirmation prompt
+This is not necessary if running outside of a terminal`)
+	cmd.Flags().StringVar(&root.opts.fromJSON, "from-json", "", `Read original paths to remove from the 'gtrash find -o json/jsonl' output
+Pass '-' to read from stdin instead of a file`)
+
+	root.cmd = cmd
+	return root
+}
+
+func removeCmdRun(args []string, opts removeOptions) error {
+	if opts.fromJSON != "" {
+		paths, err := pathsFromJSON(opts.fromJSON)
+		if err != nil {
+			return err
+		}
+		args = append(args, paths...)
+	}
+
+	if len(args) == 0 {
+		return errors.New("requires at least 1 arg, or --from-json")
+	}
+
+	box := trash.NewBox(
+		trash.WithAscend(true),
+		trash.WithQueries(args),
+		trash.WithQueryMatchAny(true), // a file can only match one of several distinct full paths
+		trash.WithQueryMode(trash.ModeByFull),
+	)
+	if err := box.Open(); err != nil {
+		return err
+	}
+
+	listFiles(box.Files, false, false)
+
+	for _, arg := range args {
+		if box.HitByPath(arg) == 0 {
+			glog.Errorf("cannot remove %q: not found in trashcan\n", arg)
+		}
+	}
+	fmt.Printf("\nFound %d trashed files\n", len(box.Files))
+
+	if !opts.force && isTerminal && !tui.BoolPrompt("Are you sure you want to remove PERMENANTLY? ") {
+		return errors.New("do nothing")
+	}
+
+	if err := doRemove(box.Files); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func doRemove(files []trash.File) error {
+	var failed []trash.File
+
+	for _, file := range files {
+		slog.Debug("removing a trashed file", "path", file.TrashPath)
+		if err := os.RemoveAll(file.TrashPath); err != nil {
+			if !errors.Is(err, os.ErrNotExist) {
+				glog.Errorf("cannot remove %q: remove: %s\n", file.TrashPath, err)
+				failed = append(failed, file)
+				continue
+			}
+		}
+		if err := file.Delete(); err != nil {
+			// already read, so it is usually not reached
+			slog.Warn("removed trashed file but cannot delete .trashinfo", "deletedFile", file.TrashPath, "trashInfoPath", file.TrashInfoPath, "error", err)
+		}
+	}
+
+	fmt.Printf("Removed %d/%d trashed files\n", len(files)-len(failed), len(files))
+	if len(failed) > 0 {
+		fmt.Printf("Following %d files could not be deleted.\n", len(failed))
+		listFiles(failed, false, true)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the schema of $XDG_CONFIG_HOME/gtrash/config.yaml.
+type Config struct {
+	// DefaultLifetime is applied by 'gtrash autoclean' and find's
+	// --expired/--expires-in filters to files with no expires_at recorded
+	// at deletion time (e.g. "14d", "72h"). When empty, both fall back to 30d.
+	DefaultLifetime string `yaml:"default_lifetime"`
+}
+
+// Path returns $XDG_CONFIG_HOME/gtrash/config.yaml ($HOME/.config/gtrash/config.yaml).
+func Path() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "gtrash", "config.yaml"), nil
+}
+
+// Load reads the config file, returning a zero Config if it does not exist.
+func Load() (Config, error) {
+	path, err := Path()
+	if err != nil {
+		return Config{}, err
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return Config{}, nil
+		}
+		return Config{}, err
+	}
+
+	var c Config
+	if err := yaml.Unmarshal(b, &c); err != nil {
+		return Config{}, err
+	}
+
+	return c, nil
+}
@@ -0,0 +1,47 @@
+package trash
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// ParseDuration parses a duration string the same way time.ParseDuration
+// does, but additionally accepts the "d" (day) and "w" (week) units used
+// throughout gtrash's retention flags, e.g. "10s", "72h", "14d", "4w".
+// A "d" or "w" value must be a single number with that one unit; combinations
+// such as "1d12h" are rejected.
+func ParseDuration(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, fmt.Errorf("duration must not be empty")
+	}
+
+	if d, err := time.ParseDuration(s); err == nil {
+		return d, nil
+	}
+
+	unit := s[len(s)-1:]
+	if unit != "d" && unit != "w" {
+		return 0, fmt.Errorf("invalid duration %q: unknown unit, use one of ns/us/ms/s/m/h/d/w", s)
+	}
+
+	n, err := strconv.ParseFloat(strings.TrimSuffix(s, unit), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q: %w", s, err)
+	}
+
+	day := 24 * time.Hour
+	if unit == "w" {
+		return time.Duration(n * float64(7*day)), nil
+	}
+	return time.Duration(n * float64(day)), nil
+}
+
+// DurationFlagCompletionFunc suggests commonly used duration values for
+// flags parsed with ParseDuration, such as --newer-than/--older-than.
+func DurationFlagCompletionFunc(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	return []string{"1h", "24h", "7d", "14d", "4w"}, cobra.ShellCompDirectiveNoFileComp
+}
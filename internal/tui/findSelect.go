@@ -0,0 +1,425 @@
+package tui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/dustin/go-humanize"
+	"github.com/umlx5h/gtrash/internal/posix"
+	"github.com/umlx5h/gtrash/internal/trash"
+	"github.com/umlx5h/gtrash/internal/tui/table"
+)
+
+// FindAction is the action chosen from the 'gtrash find' interactive selector.
+type FindAction int
+
+const (
+	FindActionNone FindAction = iota
+	FindActionRestore
+	FindActionRemove
+)
+
+var _ tea.Model = findSelectModel{}
+
+type findKeymap struct {
+	help, quit, toggle, restore, remove, preview, trashPath key.Binding
+	filter, clear, pageup, pagedown, top, bottom            key.Binding
+}
+
+var baseFindKeymap = findKeymap{
+	quit: key.NewBinding(
+		key.WithKeys("q", "ctrl+c"),
+		key.WithHelp("q/CTRL-C", "quit"),
+	),
+	toggle: key.NewBinding(
+		key.WithKeys(" "),
+		key.WithHelp("Space", "toggle selection"),
+	),
+	restore: key.NewBinding(
+		key.WithKeys("r"),
+		key.WithHelp("r", "restore"),
+	),
+	remove: key.NewBinding(
+		key.WithKeys("D"),
+		key.WithHelp("D", "remove PERMANENTLY"),
+	),
+	preview: key.NewBinding(
+		key.WithKeys("p"),
+		key.WithHelp("p", "toggle preview"),
+	),
+	trashPath: key.NewBinding(
+		key.WithKeys("t"),
+		key.WithHelp("t", "toggle trash path column"),
+	),
+	filter: key.NewBinding(
+		key.WithKeys("/"),
+		key.WithHelp("/", "filter"),
+	),
+	clear: key.NewBinding(
+		key.WithKeys("esc"),
+		key.WithHelp("ESC", "clear filter"),
+	),
+	pageup: key.NewBinding(
+		key.WithKeys("u", "pgup"),
+		key.WithHelp("u/PageUp", "page up"),
+	),
+	pagedown: key.NewBinding(
+		key.WithKeys("ctrl+d", "pgdn"),
+		key.WithHelp("CTRL-D/PageDown", "page down"),
+	),
+	top: key.NewBinding(
+		key.WithKeys("g", "home"),
+		key.WithHelp("g/Home", "go to top"),
+	),
+	bottom: key.NewBinding(
+		key.WithKeys("G", "end"),
+		key.WithHelp("G/End", "go to bottom"),
+	),
+}
+
+type findSelectModel struct {
+	width       int
+	height      int
+	fixedWidth  int
+	tableHeight int
+
+	wrapStyle lipgloss.Style
+
+	table table.Model
+	input textinput.Model // case-insensitive substring filter (not fuzzy)
+
+	files []trash.File // table source
+
+	selected map[int]struct{} // selected indices of files
+
+	keymap findKeymap
+	help   help.Model
+
+	showTrashPath bool
+	showPreview   bool
+
+	confirmed bool       // true once an action has been chosen
+	action    FindAction // chosen action
+	result    []trash.File
+
+	hit, total, hitWidth int
+}
+
+func makeFindRow(idx int, f trash.File, selected bool, showTrashPath bool) table.Row {
+	mark := " "
+	if selected {
+		mark = "*"
+	}
+
+	row := []string{
+		strconv.Itoa(idx + 1),
+		mark,
+		humanize.Time(f.DeletedAt),
+		strings.TrimSuffix(f.OriginalPathFormat(true, true), "\033[0m"),
+	}
+	if showTrashPath {
+		row = append(row, f.TrashPath)
+	}
+	return row
+}
+
+func findColumns(noWidth int, showTrashPath bool, pathWidth int) []table.Column {
+	columns := []table.Column{
+		{Title: "No", Width: noWidth},
+		{Title: "Sel", Width: 3},
+		{Title: "DeletedAt", Width: 12},
+		{Title: "Path", Width: pathWidth},
+	}
+	if showTrashPath {
+		columns = append(columns, table.Column{Title: "TrashPath", Width: pathWidth})
+	}
+	return columns
+}
+
+// newFindSelectModel builds the interactive multi-select model used by
+// 'gtrash find --interactive'.
+func newFindSelectModel(files []trash.File, showTrashPath bool) findSelectModel {
+	width, height := getTermSize()
+
+	noWidth := len(strconv.Itoa(len(files)))
+	if noWidth <= 1 {
+		noWidth = 2
+	}
+
+	paddingWidth := 5 * 2
+	fixedWidth := noWidth + 3 + 12 + paddingWidth
+
+	pathWidth := width - fixedWidth
+	if showTrashPath {
+		pathWidth = (width - fixedWidth) / 2
+	}
+
+	rows := make([]table.Row, len(files))
+	for i, f := range files {
+		rows[i] = makeFindRow(i, f, false, showTrashPath)
+	}
+
+	tableHeight := int(float64(height)*0.55) - paddingHeight
+
+	t := table.New(
+		table.WithColumns(findColumns(noWidth, showTrashPath, pathWidth)),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(tableHeight),
+	)
+	t.SetStyles(focusRowStyle)
+
+	i := textinput.New()
+	i.PromptStyle = greyStyle
+	i.Cursor.Style = inputCursorStyle
+
+	m := findSelectModel{
+		width:       width,
+		height:      height,
+		fixedWidth:  fixedWidth,
+		tableHeight: tableHeight,
+
+		wrapStyle: lipgloss.NewStyle().Width(width).Height(height).MaxWidth(width).MaxHeight(height),
+
+		table: t,
+		input: i,
+		files: files,
+
+		selected: make(map[int]struct{}),
+
+		keymap: baseFindKeymap,
+		help:   baseHelp,
+
+		showTrashPath: showTrashPath,
+		showPreview:   true,
+
+		total:    len(rows),
+		hit:      len(rows),
+		hitWidth: len(strconv.Itoa(len(rows))),
+	}
+	m.updateInputPrompt()
+
+	return m
+}
+
+func (m findSelectModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m *findSelectModel) getSelectedIdx() (int, bool) {
+	row := m.table.SelectedRow()
+	if row == nil {
+		return 0, false
+	}
+	idx, err := strconv.Atoi(row[0])
+	if err != nil {
+		panic(err)
+	}
+	return idx - 1, true
+}
+
+// actingOn returns the files an action ('r'/'D') should apply to: the
+// current selection, or just the row under the cursor when nothing is
+// explicitly selected.
+func (m *findSelectModel) actingOn() []trash.File {
+	if len(m.selected) > 0 {
+		indices := make([]int, 0, len(m.selected))
+		for idx := range m.selected {
+			indices = append(indices, idx)
+		}
+		files := make([]trash.File, len(indices))
+		for i, idx := range indices {
+			files[i] = m.files[idx]
+		}
+		return files
+	}
+
+	if idx, ok := m.getSelectedIdx(); ok {
+		return []trash.File{m.files[idx]}
+	}
+	return nil
+}
+
+func (m findSelectModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.table.Focused() {
+			switch {
+			case key.Matches(msg, m.keymap.quit):
+				return m, tea.Quit
+			case key.Matches(msg, m.keymap.filter):
+				m.table.Blur()
+				m.input.Focus()
+				return m, nil
+			case key.Matches(msg, m.keymap.clear):
+				if m.input.Value() != "" {
+					m.input.Reset()
+					m.filterApply()
+				}
+				return m, nil
+			case key.Matches(msg, m.keymap.preview):
+				m.showPreview = !m.showPreview
+				return m, nil
+			case key.Matches(msg, m.keymap.trashPath):
+				m.showTrashPath = !m.showTrashPath
+				m.rebuildTable()
+				return m, nil
+			case key.Matches(msg, m.keymap.toggle):
+				if idx, ok := m.getSelectedIdx(); ok {
+					if _, marked := m.selected[idx]; marked {
+						delete(m.selected, idx)
+					} else {
+						m.selected[idx] = struct{}{}
+					}
+					m.rebuildTable()
+				}
+				return m, nil
+			case key.Matches(msg, m.keymap.restore):
+				files := m.actingOn()
+				if len(files) == 0 {
+					return m, nil
+				}
+				m.confirmed = true
+				m.action = FindActionRestore
+				m.result = files
+				return m, tea.Quit
+			case key.Matches(msg, m.keymap.remove):
+				files := m.actingOn()
+				if len(files) == 0 {
+					return m, nil
+				}
+				m.confirmed = true
+				m.action = FindActionRemove
+				m.result = files
+				return m, tea.Quit
+			}
+
+			m.table, cmd = m.table.Update(msg)
+			return m, cmd
+		} else if m.input.Focused() {
+			switch msg.String() {
+			case "enter", "esc":
+				m.input.Blur()
+				m.table.Focus()
+			case "ctrl+c":
+				m.input.Blur()
+				m.table.Focus()
+				return m, nil
+			}
+			m.input, cmd = m.input.Update(msg)
+			m.filterApply()
+
+			return m, cmd
+		}
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.updateScreenSize()
+	}
+
+	return m, nil
+}
+
+func (m *findSelectModel) updateScreenSize() {
+	m.wrapStyle = m.wrapStyle.Width(m.width).Height(m.height).MaxWidth(m.width).MaxHeight(m.height)
+
+	newHeight := int(float64(m.height)*0.55 - paddingHeight)
+	if newHeight < 1 {
+		newHeight = 0
+	}
+	m.table.SetHeight(newHeight)
+	m.tableHeight = newHeight
+}
+
+func (m *findSelectModel) updateInputPrompt() {
+	m.input.Prompt = fmt.Sprintf("Find %*d/%d (%d selected) > ", m.hitWidth, m.hit, m.total, len(m.selected))
+}
+
+func (m *findSelectModel) updateHit() {
+	m.total = len(m.files)
+	m.hit = len(m.table.Rows())
+	m.updateInputPrompt()
+}
+
+// rebuildTable redraws rows/columns after a selection or column-visibility
+// change, preserving the current filter and cursor position.
+func (m *findSelectModel) rebuildTable() {
+	cursor := m.table.Cursor()
+
+	pathWidth := m.width - m.fixedWidth
+	if m.showTrashPath {
+		pathWidth = (m.width - m.fixedWidth) / 2
+	}
+	m.table.SetColumns(findColumns(m.hitWidth, m.showTrashPath, pathWidth))
+
+	m.filterApply()
+
+	if cursor < len(m.table.Rows()) {
+		m.table.SetCursor(cursor)
+	}
+}
+
+func (m *findSelectModel) filterApply() {
+	m.table.GotoTop()
+
+	var rows []table.Row
+	for i, f := range m.files {
+		if m.input.Value() == "" || findMatch(f.OriginalPath, m.input.Value()) {
+			_, selected := m.selected[i]
+			rows = append(rows, makeFindRow(i, f, selected, m.showTrashPath))
+		}
+	}
+
+	m.table.SetRows(rows)
+	m.updateHit()
+}
+
+func (m findSelectModel) View() string {
+	var body strings.Builder
+
+	body.WriteString(" " + m.input.View() + "\n")
+	body.WriteString(focusBorderStyle.Render(m.table.View()) + "\n")
+
+	help := m.help.ShortHelpView([]key.Binding{
+		m.keymap.quit,
+		m.keymap.toggle,
+		m.keymap.restore,
+		m.keymap.remove,
+		m.keymap.filter,
+		m.keymap.preview,
+		m.keymap.trashPath,
+	})
+	body.WriteString(help + "\n")
+
+	body.WriteString(m.viewMetadata())
+
+	return m.wrapStyle.Render(body.String())
+}
+
+func (m findSelectModel) viewMetadata() string {
+	idx, ok := m.getSelectedIdx()
+	if !ok {
+		return ""
+	}
+	f := m.files[idx]
+
+	var body strings.Builder
+	body.WriteString(greyStyle.Render("OriginalPath:") + "\t" + f.OriginalPathFormat(false, true) + "\n")
+	body.WriteString(greyStyle.Render("TrashPath:   ") + "\t" + f.TrashPathColor() + "\n")
+	body.WriteString(greyStyle.Render("DeletedAt:   ") + "\t" + f.DeletedAt.Format(time.DateTime) + "\n")
+
+	if m.showPreview {
+		body.WriteString(greyStyle.Render("Preview:     ") + "\t" + posix.FileHead(f.TrashPath, m.width, m.height-m.tableHeight-paddingHeight-6))
+	}
+
+	return body.String()
+}
@@ -0,0 +1,98 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/umlx5h/gtrash/internal/trash"
+)
+
+func FilesSelect(files []trash.File) ([]trash.File, error) {
+	m := newMultiRestoreModel(files)
+	result, err := tea.NewProgram(m, tea.WithAltScreen()).Run()
+	if err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+
+	if r, ok := result.(multiRestoreModel); ok {
+		if r.confirmed {
+			return r.restoreFiles, nil
+		}
+	}
+
+	return nil, errors.New("no selected")
+}
+
+// FindSelect runs the interactive multi-select list used by
+// 'gtrash find --interactive', returning the action the user chose ('r' to
+// restore, 'D' to remove) and the files it applies to. FindActionNone is
+// returned, with a nil error, if the user quit without choosing an action.
+func FindSelect(files []trash.File, showTrashPath bool) (FindAction, []trash.File, error) {
+	m := newFindSelectModel(files, showTrashPath)
+	result, err := tea.NewProgram(m, tea.WithAltScreen()).Run()
+	if err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+
+	if r, ok := result.(findSelectModel); ok {
+		if r.confirmed {
+			return r.action, r.result, nil
+		}
+	}
+
+	return FindActionNone, nil, nil
+}
+
+func GroupSelect(groups []trash.Group) (trash.Group, error) {
+	m := newSingleRestoreModel(groups)
+	result, err := tea.NewProgram(m, tea.WithAltScreen()).Run()
+	if err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+
+	if r, ok := result.(singleRestoreModel); ok {
+		if r.confirmed {
+			return groups[r.selected], nil
+		}
+	}
+
+	return trash.Group{}, errors.New("no selected")
+}
+
+func BoolPrompt(prompt string) bool {
+	m := newBoolInputModel(prompt)
+
+	result, err := tea.NewProgram(m).Run()
+	if err != nil {
+		return false
+	}
+
+	if m, ok := result.(boolInputModel); ok {
+		return m.Confirmed() && m.Value()
+	}
+
+	return false
+}
+
+func ChoicePrompt(prompt string, choices []string, defaultValue *string) (string, error) {
+	model := newChoiceInputModel(prompt, choices, defaultValue)
+	result, err := tea.NewProgram(model).Run()
+	if err != nil {
+		return "", err
+	}
+
+	if m, ok := result.(choiceInputModel); ok {
+		if !m.Confirmed() || m.Value() == "quit" { // hard code quit
+			return "", errors.New("canceled")
+		}
+
+		return m.Value(), err
+	}
+	return "", errors.New("unexpected error in ChoicePrompt")
+}